@@ -0,0 +1,48 @@
+// Package pool implements a shape-shared allocator for Vector backing
+// arrays. For element types whose in-memory representation holds no
+// pointers, buffers are keyed by (size, alignment) together with a
+// power-of-two capacity class, so two Vector instantiations with the
+// same shape - e.g. Vector[int32] and Vector[uint32] - can reuse each
+// other's backing storage instead of round-tripping through the garbage
+// collector. A raw byte buffer is invisible to the GC, so element types
+// that do hold pointers (string, slice, map, interface, *X, or a struct
+// with one) instead get a pool keyed by their concrete type, trading
+// away cross-type sharing for a backing array the GC can still scan.
+//
+// Pooling can be disabled for debugging by building with the 'nopool'
+// tag, which swaps this package for a version that always allocates
+// fresh and never retains anything.
+package pool
+
+// minClass and maxClass bound the power-of-two capacity classes this
+// package hands out, in elements.
+const (
+	minClass uint64 = 8
+	maxClass uint64 = 1 << 20
+)
+
+// Stats is a point-in-time snapshot of allocator activity for a single
+// element shape/capacity class.
+type Stats struct {
+	Gets uint64
+	Puts uint64
+	News uint64
+}
+
+// ClassFor rounds 'capacity' elements up to the next power-of-two
+// capacity class this package pools, never below minClass. A capacity
+// above maxClass is not rounded: it is returned unchanged and forms its
+// own class.
+func ClassFor(capacity uint64) uint64 {
+	class := minClass
+
+	for class < capacity && class < maxClass {
+		class *= 2
+	}
+
+	if class < capacity {
+		class = capacity
+	}
+
+	return class
+}
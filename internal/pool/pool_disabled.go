@@ -0,0 +1,16 @@
+//go:build nopool
+
+package pool
+
+// Get always allocates a fresh slice; pooling is disabled.
+func Get[T any](capacity uint64) []T {
+	return make([]T, 0, ClassFor(capacity))
+}
+
+// Put is a no-op; pooling is disabled.
+func Put[T any](buf []T) {}
+
+// StatsFor always reports a zero Stats; pooling is disabled.
+func StatsFor[T any](capacity uint64) Stats {
+	return Stats{}
+}
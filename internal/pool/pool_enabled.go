@@ -0,0 +1,217 @@
+//go:build !nopool
+
+package pool
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// shape keys the pool for a pointer-free element type: any two types with
+// the same size and alignment can share backing storage, since there is
+// nothing in either one the GC needs to scan.
+type shape struct {
+	size  uintptr
+	align uintptr
+	class uint64
+}
+
+// typeClass keys the pool for a pointer-containing element type. Unlike
+// shape, storage is never shared across distinct types: a raw byte buffer
+// is invisible to the GC's pointer scanner, so a pointer-containing T must
+// keep its backing array as a real '[]T' the GC can still walk.
+type typeClass struct {
+	typ   reflect.Type
+	class uint64
+}
+
+// counterSet holds a shape/type's Gets/Puts/News tallies as atomics, since
+// they're incremented concurrently by every goroutine sharing that pool.
+type counterSet struct {
+	gets atomic.Uint64
+	puts atomic.Uint64
+	news atomic.Uint64
+}
+
+func (c *counterSet) snapshot() Stats {
+	return Stats{
+		Gets: c.gets.Load(),
+		Puts: c.puts.Load(),
+		News: c.news.Load(),
+	}
+}
+
+var (
+	shapePools sync.Map // shape -> *sync.Pool (of []byte)
+	typePools  sync.Map // typeClass -> *sync.Pool (of any, holding []T)
+	counters   sync.Map // shape or typeClass -> *counterSet
+)
+
+// containsPointers reports whether values of 't' may hold a pointer the GC
+// would need to trace, directly or through a field/element.
+func containsPointers(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func,
+		reflect.Interface, reflect.String, reflect.UnsafePointer:
+		return true
+
+	case reflect.Array:
+		return containsPointers(t.Elem())
+
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if containsPointers(t.Field(i).Type) {
+				return true
+			}
+		}
+
+		return false
+
+	default:
+		return false
+	}
+}
+
+// Get returns a slice of 'T' with length 0 and capacity at least
+// 'ClassFor(capacity)', drawn from the shared pool for its shape or type.
+func Get[T any](capacity uint64) []T {
+	var zero T
+	class := ClassFor(capacity)
+
+	if containsPointers(reflect.TypeOf(&zero).Elem()) {
+		return getTyped[T](class)
+	}
+
+	return getByShape[T](class)
+}
+
+// Put returns 'buf's backing array to the pool it was drawn from, keyed by
+// its element shape/type and its capacity class. The slice must not be
+// used again after being put back.
+func Put[T any](buf []T) {
+	if buf == nil {
+		return
+	}
+
+	var zero T
+	class := ClassFor(uint64(cap(buf)))
+
+	if containsPointers(reflect.TypeOf(&zero).Elem()) {
+		putTyped[T](class, buf)
+		return
+	}
+
+	putByShape[T](class, buf)
+}
+
+// StatsFor reports the current Gets/Puts/News counters for the pool that
+// would serve 'T' at the given capacity.
+func StatsFor[T any](capacity uint64) Stats {
+	var zero T
+	class := ClassFor(capacity)
+
+	var key any
+	if containsPointers(reflect.TypeOf(&zero).Elem()) {
+		key = typeClass{typ: reflect.TypeOf(&zero).Elem(), class: class}
+	} else {
+		t := reflect.TypeOf(&zero).Elem()
+		key = shape{size: t.Size(), align: uintptr(t.Align()), class: class}
+	}
+
+	if stats, ok := counters.Load(key); ok {
+		return stats.(*counterSet).snapshot()
+	}
+
+	return Stats{}
+}
+
+func shapeOf[T any]() shape {
+	var zero T
+	t := reflect.TypeOf(&zero).Elem()
+
+	return shape{size: t.Size(), align: uintptr(t.Align())}
+}
+
+func statsFor(key any) *counterSet {
+	stats, _ := counters.LoadOrStore(key, &counterSet{})
+	return stats.(*counterSet)
+}
+
+func getByShape[T any](class uint64) []T {
+	s := shapeOf[T]()
+	s.class = class
+
+	stats := statsFor(s)
+	stats.gets.Add(1)
+
+	pool, _ := shapePools.LoadOrStore(s, &sync.Pool{
+		New: func() any {
+			stats.news.Add(1)
+			return make([]byte, 0, class*uint64(s.size))
+		},
+	})
+
+	buf := pool.(*sync.Pool).Get().([]byte)
+
+	return unsafe.Slice((*T)(unsafe.Pointer(unsafe.SliceData(buf))), class)[:0]
+}
+
+func putByShape[T any](class uint64, buf []T) {
+	s := shapeOf[T]()
+	s.class = class
+
+	statsFor(s).puts.Add(1)
+
+	pool, ok := shapePools.Load(s)
+	if !ok {
+		return
+	}
+
+	// Keep the full byte capacity when handing the buffer back: per
+	// 'unsafe.SliceData's doc, a zero-capacity slice's data pointer is
+	// unspecified and may be nil, so a later 'getByShape' reconstructing
+	// a []T from a zero-cap []byte would be relying on undefined
+	// behavior to recover a valid, non-nil backing pointer.
+	var zero T
+	byteLen := class * uint64(unsafe.Sizeof(zero))
+	raw := unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(buf))), byteLen)[:0]
+	pool.(*sync.Pool).Put(raw)
+}
+
+func getTyped[T any](class uint64) []T {
+	var zero T
+	key := typeClass{typ: reflect.TypeOf(&zero).Elem(), class: class}
+
+	stats := statsFor(key)
+	stats.gets.Add(1)
+
+	pool, _ := typePools.LoadOrStore(key, &sync.Pool{
+		New: func() any {
+			stats.news.Add(1)
+			return make([]T, 0, class)
+		},
+	})
+
+	return pool.(*sync.Pool).Get().([]T)[:0]
+}
+
+func putTyped[T any](class uint64, buf []T) {
+	var zero T
+	key := typeClass{typ: reflect.TypeOf(&zero).Elem(), class: class}
+
+	statsFor(key).puts.Add(1)
+
+	pool, ok := typePools.Load(key)
+	if !ok {
+		return
+	}
+
+	full := buf[:cap(buf)]
+	for i := range full {
+		full[i] = zero
+	}
+
+	pool.(*sync.Pool).Put(full[:0])
+}
@@ -0,0 +1,97 @@
+//go:build !nopool
+
+package pool
+
+import (
+	"runtime"
+	"testing"
+)
+
+// TestGetPointerContainingTypeSurvivesGC is a regression test: Get must not
+// hand back memory the GC treats as pointer-free when T itself holds
+// pointers, or the only live reference to a value can be collected out
+// from under it.
+func TestGetPointerContainingTypeSurvivesGC(t *testing.T) {
+	buf := Get[string](16)
+	buf = append(buf, "pool-backed string that must survive")
+
+	runtime.GC()
+	runtime.GC()
+
+	if buf[0] != "pool-backed string that must survive" {
+		t.Fatalf("string did not survive GC: got %q", buf[0])
+	}
+}
+
+func TestPutPointerContainingTypeClearsSlots(t *testing.T) {
+	buf := Get[*int](8)
+	value := 42
+	buf = append(buf, &value)
+
+	Put(buf)
+
+	reused := Get[*int](8)
+	full := reused[:cap(reused)]
+
+	for i, p := range full {
+		if p != nil {
+			t.Fatalf("slot %d not cleared on Put: got %v", i, p)
+		}
+	}
+}
+
+func TestGetShapeSharedAcrossPointerFreeTypes(t *testing.T) {
+	before := StatsFor[int32](8)
+
+	a := Get[int32](8)
+	Put(a)
+
+	b := Get[uint32](8)
+	Put(b)
+
+	after := StatsFor[uint32](8)
+
+	if after.Gets != before.Gets+2 {
+		t.Fatalf("expected int32 and uint32 to share a pool, got gets before=%d after=%d", before.Gets, after.Gets)
+	}
+}
+
+func TestGetTypeClassNotSharedAcrossPointerTypes(t *testing.T) {
+	beforeStrings := StatsFor[string](8)
+	beforeInts := StatsFor[*int](8)
+
+	Put(Get[string](8))
+	Put(Get[*int](8))
+
+	afterStrings := StatsFor[string](8)
+	afterInts := StatsFor[*int](8)
+
+	if afterStrings.Gets != beforeStrings.Gets+1 {
+		t.Fatalf("string pool gets changed unexpectedly: before=%d after=%d", beforeStrings.Gets, afterStrings.Gets)
+	}
+
+	if afterInts.Gets != beforeInts.Gets+1 {
+		t.Fatalf("*int pool gets changed unexpectedly: before=%d after=%d", beforeInts.Gets, afterInts.Gets)
+	}
+}
+
+func TestClassFor(t *testing.T) {
+	cases := []struct {
+		capacity uint64
+		want     uint64
+	}{
+		{0, 8},
+		{1, 8},
+		{8, 8},
+		{9, 16},
+		{1000, 1024},
+		{1 << 20, 1 << 20},
+		{1<<20 + 1, 1<<20 + 1},
+	}
+
+	for _, c := range cases {
+		if got := ClassFor(c.capacity); got != c.want {
+			t.Errorf("ClassFor(%d) = %d, want %d", c.capacity, got, c.want)
+		}
+	}
+}
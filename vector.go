@@ -2,7 +2,10 @@ package vector
 
 import (
 	"errors"
+	"iter"
 	"slices"
+
+	"github.com/Yuuki1578/go-utils/internal/pool"
 )
 
 // Compile-time constant errors
@@ -11,11 +14,34 @@ const (
 	INDEX_OUT_OF_BOUNDS string = "Error: attempting to access invalid memory location"
 )
 
+// ErrViewInvalidated is returned by a View's accessors once its parent
+// Vector has reallocated its backing array, e.g. via a grow or a Clear.
+var ErrViewInvalidated error = errors.New("Error: view invalidated by a reallocation of its parent vector")
+
+// noCopy is a zero-size marker that makes 'go vet's copylocks analyzer
+// flag any copy of a populated Vector, the same way sync.Mutex does for
+// its own embedders. Lock/Unlock are never meant to be called; they only
+// exist so the analyzer has something to key off.
+type noCopy struct{}
+
+func (*noCopy) Lock()   {}
+func (*noCopy) Unlock() {}
+
 // Basic vector, capable of appending, popping, removing, etc.
+//
+// Do not copy a Vector by value once it has been populated: '__slice' may
+// alias 'bootstrap', and a by-value copy would leave two Vectors reading
+// and writing the same backing array. Always hold and pass '*Vector[T]' -
+// 'WithCapacity'/'New' already return one, and 'go vet' will flag any
+// by-value copy of the pointed-to Vector.
 type Vector[T any] struct {
-	__slice []T
-	__len   uint64
-	__cap   uint64
+	noCopy       noCopy
+	bootstrap    [8]T
+	__slice      []T
+	__len        uint64
+	__cap        uint64
+	__generation uint64
+	__pooled     bool
 }
 
 // Get the vector length
@@ -48,65 +74,196 @@ func (this *Vector[T]) updateStatus() {
 
 // Initialize a vector with default capacity and allocates the slices with the capacity provided first.
 // In fact, this function is an abstraction over builtin function 'make'.
-func WithCapacity[T any](capacity uint64) Vector[T] {
-	return Vector[T]{
-		__slice: make([]T, 0, capacity),
-		__len:   0,
-		__cap:   capacity,
-	}
+//
+// Capacities of 8 or below are served out of the vector's own
+// 'bootstrap' array instead of calling 'make', avoiding the first heap
+// allocation for small, short-lived vectors.
+//
+// WithCapacity returns a pointer rather than a Vector[T] by value: the
+// bootstrap path aliases the vector's own 'bootstrap' field, so the
+// vector's address must already be final before that alias is set up,
+// not settled afterward by a copy out of a local variable.
+func WithCapacity[T any](capacity uint64) *Vector[T] {
+	vector := new(Vector[T])
+	vector.ensureStorage(capacity)
+
+	return vector
 }
 
 // Initialize a vector, it doesn't allocate the memory yet.
 // The initial capacity is 0. If you want to specify the default
 // capacity, use 'WithCapacity' instead.
-func New[T any]() Vector[T] {
+func New[T any]() *Vector[T] {
 	return WithCapacity[T](0)
 }
 
+// ensureStorage materializes this vector's backing array in place: from
+// its own 'bootstrap' field for capacities of 8 or below, or from the
+// shared pool ('pool.Get') otherwise. Callers must only invoke this on a receiver
+// whose address is already final, so the bootstrap alias doesn't
+// outlive a copy of the struct.
+func (this *Vector[T]) ensureStorage(capacity uint64) {
+	if capacity <= 8 {
+		this.__slice = this.bootstrap[:0:8]
+		this.__cap = 8
+		this.__pooled = false
+
+		return
+	}
+
+	this.__slice = pool.Get[T](capacity)
+	this.__pooled = true
+	this.updateStatus()
+}
+
+// releaseBacking returns this vector's backing array to the shared pool
+// if it was drawn from one, i.e. it isn't aliasing 'bootstrap'. It is a
+// no-op otherwise, and always leaves '__pooled' false.
+func (this *Vector[T]) releaseBacking() {
+	if this.__pooled && this.__slice != nil {
+		pool.Put(this.__slice)
+	}
+
+	this.__pooled = false
+}
+
 // Adding additional capacity to vector, if the pointer to instance (that called by the method)
 // is somehow 'nil', it return error. If it's NOT nil, it return nil instead.
 func (this *Vector[T]) AddCapacity(capacity uint64) error {
-	var safeCopy []T
+	if this == nil {
+		return errors.New(NIL_VALUE_ACCESS)
+	}
+
+	if this.__slice == nil {
+		this.ensureStorage(capacity)
+		return nil
+	}
 
+	this.grow(this.__cap + capacity)
+
+	return nil
+}
+
+// Reserve ensures the vector has spare capacity for at least 'n' more
+// elements without triggering a further reallocation on the next few
+// appends/inserts.
+func (this *Vector[T]) Reserve(n uint64) error {
+	if this == nil {
+		return errors.New(NIL_VALUE_ACCESS)
+	}
+
+	if this.__slice == nil {
+		this.ensureStorage(0)
+	}
+
+	this.grow(this.__len + n)
+
+	return nil
+}
+
+// ShrinkTo reallocates the vector down to 'cap', or to the current
+// length if 'cap' is smaller than it. It is the counterpart of 'Reserve'
+// and never grows the vector.
+func (this *Vector[T]) ShrinkTo(cap uint64) error {
 	if this == nil {
 		return errors.New(NIL_VALUE_ACCESS)
 	}
 
 	if this.__slice == nil {
-		*this = WithCapacity[T](capacity)
 		return nil
 	}
 
-	var _ = copy(safeCopy, this.__slice)
+	if cap < this.__len {
+		cap = this.__len
+	}
 
-	this.__slice = nil
-	*this = WithCapacity[T](uint64(cap(safeCopy) + int(capacity)))
-	this.__slice = append(this.__slice, safeCopy...)
-	this.updateStatus()
+	if cap >= this.__cap {
+		return nil
+	}
 
-	safeCopy = nil
+	newSlice := make([]T, this.__len, cap)
+	copy(newSlice, this.__slice)
+	this.releaseBacking()
+	this.__slice = newSlice
+	this.__generation++
+	this.updateStatus()
 
 	return nil
 }
 
+// grow ensures the backing array can hold at least 'needed' elements,
+// following the same growth policy as the Go runtime's growslice: below
+// 256 elements the capacity doubles, from 256 on it grows by
+// (newCap + 3*256) / 4 per step, a factor that starts at 2x and falls
+// toward 1.25x as the capacity gets larger. The target is then handed to 'pool.Get', which
+// rounds it up to its own allocator-friendly capacity class - so this
+// function no longer rounds the byte size itself, to avoid compounding
+// two independent roundings into an oversized buffer.
+func (this *Vector[T]) grow(needed uint64) {
+	if this.__cap >= needed {
+		return
+	}
+
+	newCap := this.__cap
+	if newCap == 0 {
+		newCap = 1
+	}
+
+	for newCap < needed {
+		if newCap < 256 {
+			newCap *= 2
+		} else {
+			newCap += (newCap + 3*256) / 4
+		}
+	}
+
+	newSlice := pool.Get[T](newCap)[:this.__len]
+	copy(newSlice, this.__slice)
+	this.releaseBacking()
+	this.__slice = newSlice
+	this.__pooled = true
+	this.__generation++
+	this.updateStatus()
+}
+
 // Clear the vector, truncating it to initialization / zero value.
 func (this *Vector[T]) Clear() {
 	if this == nil {
 		return
 	}
 
+	generation := this.__generation + 1
+
+	this.releaseBacking()
 	this.__slice = nil
-	*this = New[T]()
+	this.ensureStorage(0)
+	this.__generation = generation
 	this.updateStatus()
 }
 
-// Deallocates the remaining capacity of vector.
+// Strip deallocates the vector's remaining spare capacity, shrinking it
+// to exactly its current length.
+//
+// This cannot be a plain re-slice ('slices.Clip'): the pool classifies a
+// returned buffer by 'cap(buf)' alone, so handing back a re-sliced
+// buffer would file the *entire* original backing array under the much
+// smaller class implied by the new length, permanently orphaning the
+// rest of it. Strip instead reallocates, like 'ShrinkTo', so the old
+// backing array is released at its real class.
 func (this *Vector[T]) Strip() {
-	if this == nil {
+	if this == nil || this.__slice == nil {
 		return
 	}
 
-	this.__slice = slices.Clip(this.__slice)
+	if uint64(cap(this.__slice)) == this.__len {
+		return
+	}
+
+	newSlice := make([]T, this.__len, this.__len)
+	copy(newSlice, this.__slice)
+	this.releaseBacking()
+	this.__slice = newSlice
+	this.__generation++
 	this.updateStatus()
 }
 
@@ -129,48 +286,343 @@ func (this *Vector[T]) Append(element T) error {
 	}
 
 	if this.__slice == nil {
-		*this = New[T]()
+		this.ensureStorage(0)
 	}
 
-	this.__slice = append(this.__slice, element)
+	this.grow(this.__len + 1)
+	this.__slice = append(this.__slice[:this.__len], element)
 	this.updateStatus()
 
 	return nil
 }
 
+// Insert places a single element at the given index, shifting every
+// element at and after 'index' one slot to the right. Returns
+// 'INDEX_OUT_OF_BOUNDS' if index is greater than the current length.
+func (this *Vector[T]) Insert(index uint64, element T) error {
+	return this.InsertMany(index, element)
+}
+
+// InsertMany places 'elements' starting at 'index', shifting the existing
+// suffix '[index:]' to the right to make room. If the current capacity
+// cannot hold the extra elements, the backing array is first reallocated
+// through 'grow' (its growth policy and pool class rounding apply), and
+// the suffix is then shifted within the new array. Returns 'INDEX_OUT_OF_BOUNDS' if index is greater than the
+// current length.
+func (this *Vector[T]) InsertMany(index uint64, elements ...T) error {
+	if this == nil {
+		return errors.New(NIL_VALUE_ACCESS)
+	}
+
+	if index > this.Len() {
+		return errors.New(INDEX_OUT_OF_BOUNDS)
+	}
+
+	if this.__slice == nil {
+		this.ensureStorage(0)
+	}
+
+	n := uint64(len(elements))
+	if n == 0 {
+		return nil
+	}
+
+	oldLen := this.__len
+	this.grow(oldLen + n)
+
+	this.__slice = this.__slice[:oldLen+n]
+	copy(this.__slice[index+n:], this.__slice[index:oldLen])
+	copy(this.__slice[index:index+n], elements)
+	this.updateStatus()
+
+	return nil
+}
+
+// Extend grows the vector's length by 'n' zero values, inserted at the
+// tail. It is a thin wrapper over 'InsertMany'.
+func (this *Vector[T]) Extend(n uint64) error {
+	if this == nil {
+		return errors.New(NIL_VALUE_ACCESS)
+	}
+
+	return this.InsertMany(this.Len(), make([]T, n)...)
+}
+
 // Popping the value out of the vector, if the instance is nil or the index is greater than / equal to instance length,
 // It will return the default value of 'T' and error.
 func (this *Vector[T]) Pop(index uint64) (T, error) {
-	var (
-		defaultValue T
-		leftSide     []T = nil
-		rightSide    []T = nil
-		safeCopy     []T = nil
-		_            int
-	)
+	var defaultValue T
 
 	if this == nil || this.__slice == nil {
 		return defaultValue, errors.New(NIL_VALUE_ACCESS)
 	}
 
-	if this.Len() >= index {
+	if index >= this.__len {
 		return defaultValue, errors.New(INDEX_OUT_OF_BOUNDS)
 	}
 
 	defaultValue = this.__slice[index]
-	leftSide = this.__slice[:index]
-	rightSide = this.__slice[index+1:]
+	this.__slice = slices.Delete(this.__slice, int(index), int(index+1))
+	this.updateStatus()
 
-	safeCopy = make([]T, 0, this.Cap()-1)
-	safeCopy = append(safeCopy, leftSide...)
-	safeCopy = append(safeCopy, rightSide...)
+	return defaultValue, nil
+}
 
-	this.Clear()
+// All returns an iterator over (index, value) pairs, from front to back.
+func (this *Vector[T]) All() iter.Seq2[uint64, T] {
+	return func(yield func(uint64, T) bool) {
+		if this == nil {
+			return
+		}
+
+		for i := uint64(0); i < this.__len; i++ {
+			if !yield(i, this.__slice[i]) {
+				return
+			}
+		}
+	}
+}
 
-	_ = copy(this.__slice, safeCopy)
-	this.updateStatus()
+// Values returns an iterator over the vector's elements, from front to back.
+func (this *Vector[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if this == nil {
+			return
+		}
+
+		for i := uint64(0); i < this.__len; i++ {
+			if !yield(this.__slice[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over (index, value) pairs, from back to front.
+func (this *Vector[T]) Backward() iter.Seq2[uint64, T] {
+	return func(yield func(uint64, T) bool) {
+		if this == nil {
+			return
+		}
+
+		for i := this.__len; i > 0; i-- {
+			if !yield(i-1, this.__slice[i-1]) {
+				return
+			}
+		}
+	}
+}
 
-	safeCopy = nil
+// Collect builds a vector from a sequence, e.g. the output of 'slices.Values'
+// or another vector's 'Values'.
+func Collect[T any](seq iter.Seq[T]) *Vector[T] {
+	vector := New[T]()
 
-	return defaultValue, nil
+	for value := range seq {
+		_ = vector.Append(value)
+	}
+
+	return vector
+}
+
+// Map applies 'f' to every element of 'v' and collects the results into a
+// new vector.
+func Map[T, U any](v *Vector[T], f func(T) U) *Vector[U] {
+	result := New[U]()
+
+	if v == nil {
+		return result
+	}
+
+	_ = result.Reserve(v.Len())
+
+	for _, value := range v.All() {
+		_ = result.Append(f(value))
+	}
+
+	return result
+}
+
+// Filter returns a new vector holding only the elements for which 'pred'
+// returns true.
+func (this *Vector[T]) Filter(pred func(T) bool) *Vector[T] {
+	result := New[T]()
+
+	if this == nil {
+		return result
+	}
+
+	for _, value := range this.All() {
+		if pred(value) {
+			_ = result.Append(value)
+		}
+	}
+
+	return result
+}
+
+// Reduce folds 'v' into a single value, starting from 'init' and combining
+// it with each element via 'f', from front to back.
+func Reduce[T, U any](v *Vector[T], init U, f func(U, T) U) U {
+	if v == nil {
+		return init
+	}
+
+	accumulator := init
+
+	for _, value := range v.All() {
+		accumulator = f(accumulator, value)
+	}
+
+	return accumulator
+}
+
+// Find returns the index and value of the first element satisfying 'pred',
+// and false if no element does.
+func (this *Vector[T]) Find(pred func(T) bool) (uint64, T, bool) {
+	var defaultValue T
+
+	if this == nil {
+		return 0, defaultValue, false
+	}
+
+	for index, value := range this.All() {
+		if pred(value) {
+			return index, value, true
+		}
+	}
+
+	return 0, defaultValue, false
+}
+
+// View is a zero-copy, immutable-length range over a Vector's backing
+// array. It cannot grow or shrink, and it cannot outlive a reallocation
+// of its parent: once the parent's generation moves on, At, Set and Slice
+// return 'ErrViewInvalidated', Len reports 0 and CopyTo copies nothing,
+// instead of reading stale or reused memory.
+type View[T any] struct {
+	__slice      []T
+	__parent     *Vector[T]
+	__generation uint64
+}
+
+// View returns a View over the elements '[lo:hi)', sharing the same
+// underlying array as the vector. Returns 'INDEX_OUT_OF_BOUNDS' if the
+// range is invalid.
+func (this *Vector[T]) View(lo uint64, hi uint64) (View[T], error) {
+	if this == nil {
+		return View[T]{}, errors.New(NIL_VALUE_ACCESS)
+	}
+
+	if lo > hi || hi > this.__len {
+		return View[T]{}, errors.New(INDEX_OUT_OF_BOUNDS)
+	}
+
+	return View[T]{
+		__slice:      this.__slice[lo:hi:hi],
+		__parent:     this,
+		__generation: this.__generation,
+	}, nil
+}
+
+// valid reports whether the view's parent has not reallocated since the
+// view was taken.
+func (this *View[T]) valid() bool {
+	return this != nil && this.__parent != nil && this.__parent.__generation == this.__generation
+}
+
+// Len returns the number of elements in the view.
+func (this *View[T]) Len() uint64 {
+	if !this.valid() {
+		return 0
+	}
+
+	return uint64(len(this.__slice))
+}
+
+// At returns the element at 'index', or 'ErrViewInvalidated' if the
+// parent vector has reallocated since the view was taken.
+func (this *View[T]) At(index uint64) (T, error) {
+	var defaultValue T
+
+	if !this.valid() {
+		return defaultValue, ErrViewInvalidated
+	}
+
+	if index >= uint64(len(this.__slice)) {
+		return defaultValue, errors.New(INDEX_OUT_OF_BOUNDS)
+	}
+
+	return this.__slice[index], nil
+}
+
+// Set writes 'value' at 'index', or returns 'ErrViewInvalidated' if the
+// parent vector has reallocated since the view was taken.
+func (this *View[T]) Set(index uint64, value T) error {
+	if !this.valid() {
+		return ErrViewInvalidated
+	}
+
+	if index >= uint64(len(this.__slice)) {
+		return errors.New(INDEX_OUT_OF_BOUNDS)
+	}
+
+	this.__slice[index] = value
+
+	return nil
+}
+
+// Slice returns a narrower View over '[lo:hi)' of this view, still
+// sharing the same underlying array and generation.
+func (this *View[T]) Slice(lo uint64, hi uint64) (View[T], error) {
+	if !this.valid() {
+		return View[T]{}, ErrViewInvalidated
+	}
+
+	if lo > hi || hi > uint64(len(this.__slice)) {
+		return View[T]{}, errors.New(INDEX_OUT_OF_BOUNDS)
+	}
+
+	return View[T]{
+		__slice:      this.__slice[lo:hi:hi],
+		__parent:     this.__parent,
+		__generation: this.__generation,
+	}, nil
+}
+
+// CopyTo copies as many elements as fit into both views, stopping at the
+// shorter of the two, and returns the number of elements copied. It
+// copies nothing if either view has been invalidated.
+func (this *View[T]) CopyTo(dst View[T]) uint64 {
+	if !this.valid() || !dst.valid() {
+		return 0
+	}
+
+	return uint64(copy(dst.__slice, this.__slice))
+}
+
+// Release returns 'v's backing array to the shared pool (see package
+// 'pool') instead of leaving it for the garbage collector, if the array
+// was drawn from that pool; a 'bootstrap' array, or one reallocated by
+// 'ShrinkTo' or 'Strip', is not put back. Release then resets
+// 'v' to an empty, capacity-0 vector. Any outstanding View over 'v' is
+// invalidated, since the generation bump makes it return
+// 'ErrViewInvalidated' rather than risk reading memory now owned by
+// another caller.
+func Release[T any](v *Vector[T]) {
+	if v == nil {
+		return
+	}
+
+	v.releaseBacking()
+	v.__slice = nil
+	v.__len = 0
+	v.__cap = 0
+	v.__generation++
+}
+
+// PoolStats reports the current Gets/Puts/News counters for the pool
+// that would serve a 'Vector[T]' at the given capacity.
+func PoolStats[T any](capacity uint64) pool.Stats {
+	return pool.StatsFor[T](capacity)
 }
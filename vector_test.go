@@ -0,0 +1,506 @@
+package vector
+
+import "testing"
+
+func TestWithCapacityBootstrapDoesNotAlias(t *testing.T) {
+	a := WithCapacity[int](4)
+	b := WithCapacity[int](4)
+
+	_ = a.Append(1)
+	_ = b.Append(2)
+
+	if a.bootstrap[0] != 1 {
+		t.Fatalf("a.bootstrap[0] = %d, want 1 (Append should have landed in a's own bootstrap array)", a.bootstrap[0])
+	}
+
+	if b.bootstrap[0] != 2 {
+		t.Fatalf("b.bootstrap[0] = %d, want 2 (Append should have landed in b's own bootstrap array)", b.bootstrap[0])
+	}
+}
+
+func TestAppendTriggersGrowPastBootstrap(t *testing.T) {
+	v := WithCapacity[int](4)
+
+	for i := 0; i < 9; i++ {
+		if err := v.Append(i); err != nil {
+			t.Fatalf("Append(%d) returned unexpected error: %v", i, err)
+		}
+	}
+
+	if v.Len() != 9 {
+		t.Fatalf("Len() = %d, want 9", v.Len())
+	}
+
+	for i := 0; i < 9; i++ {
+		value, err := v.Pop(0)
+		if err != nil {
+			t.Fatalf("Pop(0) returned unexpected error: %v", err)
+		}
+
+		if value != i {
+			t.Fatalf("Pop(0) = %d, want %d", value, i)
+		}
+	}
+}
+
+func TestPopOutOfBounds(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+
+	if _, err := v.Pop(1); err == nil {
+		t.Fatalf("Pop(1) on a 1-element vector should have returned an error")
+	}
+}
+
+func TestGrowDoublesBelow256(t *testing.T) {
+	v := WithCapacity[int](4)
+
+	if err := v.Reserve(100); err != nil {
+		t.Fatalf("Reserve(100) returned unexpected error: %v", err)
+	}
+
+	if v.Cap() != 128 {
+		t.Fatalf("Cap() = %d, want 128 (8 -> 16 -> 32 -> 64 -> 128)", v.Cap())
+	}
+}
+
+func TestGrowSlowsAbove256(t *testing.T) {
+	v := WithCapacity[int](4)
+
+	if err := v.Reserve(300); err != nil {
+		t.Fatalf("Reserve(300) returned unexpected error: %v", err)
+	}
+
+	if v.Cap() != 512 {
+		t.Fatalf("Cap() = %d, want 512 (8 -> 16 -> 32 -> 64 -> 128 -> 256, then 256 + (256+3*256)/4 = 512)", v.Cap())
+	}
+}
+
+func TestInsertShiftsSuffixRight(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+	_ = v.Append(3)
+
+	if err := v.Insert(1, 2); err != nil {
+		t.Fatalf("Insert(1, 2) returned unexpected error: %v", err)
+	}
+
+	for i, want := range []int{1, 2, 3} {
+		value, err := v.Pop(0)
+		if err != nil {
+			t.Fatalf("Pop(0) returned unexpected error: %v", err)
+		}
+
+		if value != want {
+			t.Fatalf("element %d = %d, want %d", i, value, want)
+		}
+	}
+}
+
+func TestInsertOutOfBounds(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+
+	if err := v.Insert(2, 9); err == nil {
+		t.Fatalf("Insert(2, 9) on a 1-element vector should have returned an error")
+	}
+}
+
+func TestInsertManyGrowsPastCapacity(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+
+	if err := v.InsertMany(1, 2, 3, 4, 5, 6, 7, 8, 9); err != nil {
+		t.Fatalf("InsertMany returned unexpected error: %v", err)
+	}
+
+	if v.Len() != 9 {
+		t.Fatalf("Len() = %d, want 9", v.Len())
+	}
+
+	for i, want := range []int{1, 2, 3, 4, 5, 6, 7, 8, 9} {
+		value, err := v.Pop(0)
+		if err != nil {
+			t.Fatalf("Pop(0) returned unexpected error: %v", err)
+		}
+
+		if value != want {
+			t.Fatalf("element %d = %d, want %d", i, value, want)
+		}
+	}
+}
+
+func TestExtendAppendsZeroValues(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+
+	if err := v.Extend(3); err != nil {
+		t.Fatalf("Extend(3) returned unexpected error: %v", err)
+	}
+
+	if v.Len() != 4 {
+		t.Fatalf("Len() = %d, want 4", v.Len())
+	}
+
+	for i := uint64(1); i < 4; i++ {
+		value, err := v.Pop(1)
+		if err != nil {
+			t.Fatalf("Pop(1) returned unexpected error: %v", err)
+		}
+
+		if value != 0 {
+			t.Fatalf("extended element %d = %d, want 0", i, value)
+		}
+	}
+}
+
+func TestValuesIteratesFrontToBack(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+	_ = v.Append(2)
+	_ = v.Append(3)
+
+	var got []int
+	for value := range v.Values() {
+		got = append(got, value)
+	}
+
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Fatalf("Values() yielded %v, want [1 2 3]", got)
+	}
+}
+
+func TestBackwardIteratesBackToFront(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+	_ = v.Append(2)
+	_ = v.Append(3)
+
+	var got []int
+	for _, value := range v.Backward() {
+		got = append(got, value)
+	}
+
+	if len(got) != 3 || got[0] != 3 || got[1] != 2 || got[2] != 1 {
+		t.Fatalf("Backward() yielded %v, want [3 2 1]", got)
+	}
+}
+
+func TestCollectBuildsVectorFromSequence(t *testing.T) {
+	source := WithCapacity[int](4)
+	_ = source.Append(1)
+	_ = source.Append(2)
+
+	collected := Collect[int](source.Values())
+
+	if collected.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", collected.Len())
+	}
+}
+
+func TestMapAppliesFunctionToEveryElement(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+	_ = v.Append(2)
+	_ = v.Append(3)
+
+	doubled := Map(v, func(x int) int { return x * 2 })
+
+	if doubled.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", doubled.Len())
+	}
+
+	for i, want := range []int{2, 4, 6} {
+		value, err := doubled.Pop(0)
+		if err != nil {
+			t.Fatalf("Pop(0) returned unexpected error: %v", err)
+		}
+
+		if value != want {
+			t.Fatalf("element %d = %d, want %d", i, value, want)
+		}
+	}
+}
+
+func TestFilterKeepsOnlyMatchingElements(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+	_ = v.Append(2)
+	_ = v.Append(3)
+	_ = v.Append(4)
+
+	even := v.Filter(func(x int) bool { return x%2 == 0 })
+
+	if even.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", even.Len())
+	}
+}
+
+func TestReduceFoldsFrontToBack(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+	_ = v.Append(2)
+	_ = v.Append(3)
+
+	sum := Reduce(v, 0, func(acc, x int) int { return acc + x })
+
+	if sum != 6 {
+		t.Fatalf("Reduce sum = %d, want 6", sum)
+	}
+}
+
+func TestFindReturnsFirstMatch(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+	_ = v.Append(2)
+	_ = v.Append(3)
+
+	index, value, ok := v.Find(func(x int) bool { return x%2 == 0 })
+	if !ok {
+		t.Fatalf("Find did not find an even element")
+	}
+
+	if index != 1 || value != 2 {
+		t.Fatalf("Find = (%d, %d), want (1, 2)", index, value)
+	}
+}
+
+func TestFindReportsNoMatch(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+
+	if _, _, ok := v.Find(func(x int) bool { return x > 10 }); ok {
+		t.Fatalf("Find reported a match where there was none")
+	}
+}
+
+func TestViewInvalidatedByGrow(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+	_ = v.Append(2)
+
+	view, err := v.View(0, 2)
+	if err != nil {
+		t.Fatalf("View(0, 2) returned unexpected error: %v", err)
+	}
+
+	for i := 0; i < 9; i++ {
+		_ = v.Append(i)
+	}
+
+	if _, err := view.At(0); err != ErrViewInvalidated {
+		t.Fatalf("At(0) after a grow = %v, want ErrViewInvalidated", err)
+	}
+}
+
+func TestAddCapacityGrowsBootstrapVector(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+
+	if err := v.AddCapacity(4); err != nil {
+		t.Fatalf("AddCapacity(4) returned unexpected error: %v", err)
+	}
+
+	if v.Cap() != 16 {
+		t.Fatalf("Cap() = %d, want 16 (8 + 4 rounds up to the next class)", v.Cap())
+	}
+}
+
+func TestAddCapacityGrowsPoolBackedVector(t *testing.T) {
+	v := WithCapacity[int](100)
+
+	if v.Cap() != 128 {
+		t.Fatalf("WithCapacity(100) Cap() = %d, want 128", v.Cap())
+	}
+
+	if err := v.AddCapacity(50); err != nil {
+		t.Fatalf("AddCapacity(50) returned unexpected error: %v", err)
+	}
+
+	if v.Cap() != 256 {
+		t.Fatalf("Cap() = %d, want 256 (128 + 50 rounds up to the next class)", v.Cap())
+	}
+}
+
+func TestShrinkToShrinksAndInvalidatesView(t *testing.T) {
+	v := WithCapacity[int](1000)
+	_ = v.Append(1)
+	_ = v.Append(2)
+
+	view, err := v.View(0, 2)
+	if err != nil {
+		t.Fatalf("View(0, 2) returned unexpected error: %v", err)
+	}
+
+	if err := v.ShrinkTo(2); err != nil {
+		t.Fatalf("ShrinkTo(2) returned unexpected error: %v", err)
+	}
+
+	if v.Cap() != 2 {
+		t.Fatalf("Cap() = %d, want 2", v.Cap())
+	}
+
+	if _, err := view.At(0); err != ErrViewInvalidated {
+		t.Fatalf("At(0) after ShrinkTo = %v, want ErrViewInvalidated", err)
+	}
+}
+
+func TestShrinkToNeverGrows(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+
+	if err := v.ShrinkTo(1000); err != nil {
+		t.Fatalf("ShrinkTo(1000) returned unexpected error: %v", err)
+	}
+
+	if v.Cap() != 8 {
+		t.Fatalf("Cap() = %d, want 8 (ShrinkTo must not grow the vector)", v.Cap())
+	}
+}
+
+func TestViewInvalidatedByClear(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+	_ = v.Append(2)
+
+	view, err := v.View(0, 2)
+	if err != nil {
+		t.Fatalf("View(0, 2) returned unexpected error: %v", err)
+	}
+
+	v.Clear()
+
+	if _, err := view.At(0); err != ErrViewInvalidated {
+		t.Fatalf("At(0) after a Clear = %v, want ErrViewInvalidated", err)
+	}
+
+	if err := view.Set(0, 9); err != ErrViewInvalidated {
+		t.Fatalf("Set(0, 9) after a Clear = %v, want ErrViewInvalidated", err)
+	}
+}
+
+func TestViewAtAndSet(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+	_ = v.Append(2)
+	_ = v.Append(3)
+
+	view, err := v.View(1, 3)
+	if err != nil {
+		t.Fatalf("View(1, 3) returned unexpected error: %v", err)
+	}
+
+	if view.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", view.Len())
+	}
+
+	value, err := view.At(0)
+	if err != nil {
+		t.Fatalf("At(0) returned unexpected error: %v", err)
+	}
+
+	if value != 2 {
+		t.Fatalf("At(0) = %d, want 2", value)
+	}
+
+	if err := view.Set(0, 20); err != nil {
+		t.Fatalf("Set(0, 20) returned unexpected error: %v", err)
+	}
+
+	if popped, _ := v.Pop(1); popped != 20 {
+		t.Fatalf("Pop(1) after Set through the view = %d, want 20 (the view should share the vector's backing array)", popped)
+	}
+}
+
+func TestViewSliceNarrowsRange(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+	_ = v.Append(2)
+	_ = v.Append(3)
+	_ = v.Append(4)
+
+	view, err := v.View(0, 4)
+	if err != nil {
+		t.Fatalf("View(0, 4) returned unexpected error: %v", err)
+	}
+
+	narrowed, err := view.Slice(1, 3)
+	if err != nil {
+		t.Fatalf("Slice(1, 3) returned unexpected error: %v", err)
+	}
+
+	if narrowed.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", narrowed.Len())
+	}
+
+	value, err := narrowed.At(0)
+	if err != nil {
+		t.Fatalf("At(0) returned unexpected error: %v", err)
+	}
+
+	if value != 2 {
+		t.Fatalf("At(0) = %d, want 2", value)
+	}
+}
+
+func TestViewCopyToStopsAtShorterView(t *testing.T) {
+	src := WithCapacity[int](4)
+	_ = src.Append(1)
+	_ = src.Append(2)
+	_ = src.Append(3)
+
+	dst := WithCapacity[int](4)
+	_ = dst.Append(0)
+	_ = dst.Append(0)
+
+	srcView, err := src.View(0, 3)
+	if err != nil {
+		t.Fatalf("src.View(0, 3) returned unexpected error: %v", err)
+	}
+
+	dstView, err := dst.View(0, 2)
+	if err != nil {
+		t.Fatalf("dst.View(0, 2) returned unexpected error: %v", err)
+	}
+
+	copied := srcView.CopyTo(dstView)
+	if copied != 2 {
+		t.Fatalf("CopyTo returned %d, want 2 (the shorter of the two views)", copied)
+	}
+
+	for i, want := range []int{1, 2} {
+		value, err := dst.Pop(0)
+		if err != nil {
+			t.Fatalf("Pop(0) returned unexpected error: %v", err)
+		}
+
+		if value != want {
+			t.Fatalf("element %d = %d, want %d", i, value, want)
+		}
+	}
+}
+
+func TestReleaseResetsVectorAndInvalidatesView(t *testing.T) {
+	v := WithCapacity[int](4)
+	_ = v.Append(1)
+	_ = v.Append(2)
+
+	view, err := v.View(0, 2)
+	if err != nil {
+		t.Fatalf("View(0, 2) returned unexpected error: %v", err)
+	}
+
+	Release(v)
+
+	if v.Len() != 0 {
+		t.Fatalf("Len() after Release = %d, want 0", v.Len())
+	}
+
+	if v.Cap() != 0 {
+		t.Fatalf("Cap() after Release = %d, want 0", v.Cap())
+	}
+
+	if _, err := view.At(0); err != ErrViewInvalidated {
+		t.Fatalf("At(0) after Release = %v, want ErrViewInvalidated", err)
+	}
+}